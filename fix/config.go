@@ -0,0 +1,18 @@
+package fix
+
+// Config controls optional, opt-in relaxations of the default Value behavior.
+type Config struct {
+	// StrictSet, when true, restricts Set(interface{}) to the exact Go type
+	// each Value historically accepted (e.g. Int.Set only accepts int). When
+	// false, Set accepts the broadened conversion matrix also used by Scan
+	// (other integer widths, strings, json.Number, etc).
+	StrictSet bool
+}
+
+// DefaultConfig is read by the Set methods of Raw, String, Int, Uint, Float,
+// Time, Bool and Decimal. The temporal types, MonthYear and Data do not read
+// it.
+// It defaults to StrictSet: true so existing callers keep their current
+// behavior; set DefaultConfig.StrictSet = false to opt into the wider
+// conversion matrix for Set.
+var DefaultConfig = Config{StrictSet: true}
@@ -0,0 +1,111 @@
+package fix
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+)
+
+// Data is a binary-safe Value for FIX's length-prefixed data fields (Data,
+// XMLData, RawData, SecureData, ...). Its payload may contain the SOH byte
+// (0x01) or NULs, so FromBytes treats its input as an opaque byte slice
+// rather than scanning for a delimiter: the caller (the message parser) is
+// responsible for reading exactly the number of bytes declared by the
+// paired Length field and handing that slice to FromBytes.
+//
+// This package has no message-level dictionary or parser of its own, so the
+// Length<->Data tag pairing below is a standalone registry; wiring it into a
+// concrete FIX message parser is left to that parser.
+type Data struct {
+	value []byte
+	valid bool
+}
+
+// NewData creates a new Data value from a raw payload.
+func NewData(b []byte) *Data {
+	return &Data{value: b, valid: true}
+}
+
+func (v *Data) IsNull() bool       { return !v.valid }
+func (v *Data) IsEmpty() bool      { return !v.valid || len(v.value) == 0 }
+func (v *Data) Value() interface{} { return v.value }
+func (v *Data) String() string     { return string(v.value) }
+
+func (v *Data) ToBytes() []byte {
+	if !v.valid {
+		return nil
+	}
+	return v.value
+}
+
+func (v *Data) WriteBytes(writer *bytes.Buffer) bool {
+	if !v.valid {
+		return false
+	}
+	_, _ = writer.Write(v.value)
+	return true
+}
+
+// FromBytes stores d verbatim. d is expected to already be exactly the
+// number of bytes declared by the paired Length field; no SOH scan is done.
+func (v *Data) FromBytes(d []byte) error {
+	if d == nil {
+		v.valid = false
+		return nil
+	}
+	v.value = d
+	v.valid = true
+	return nil
+}
+
+// Set accepts either a []byte payload or an io.Reader, which is read to
+// completion so large or streamed payloads (news XML bodies, user-defined
+// blobs) can be assigned without the caller buffering them first.
+func (v *Data) Set(d interface{}) error {
+	switch t := d.(type) {
+	case nil:
+		v.valid = false
+		return nil
+	case []byte:
+		v.value = t
+		v.valid = true
+		return nil
+	case io.Reader:
+		b, err := io.ReadAll(t)
+		if err != nil {
+			return fmt.Errorf("could not read %s: %w", "Data", err)
+		}
+		v.value = b
+		v.valid = true
+		return nil
+	}
+
+	return fmt.Errorf("could not convert %v to %s", d, "Data")
+}
+
+// Scan converts the Data value into dest.
+func (v *Data) Scan(dest interface{}) error {
+	return Scan(v, dest)
+}
+
+// Len returns the number of bytes the paired Length field must carry for
+// this Data value.
+func (v *Data) Len() int {
+	return len(v.value)
+}
+
+// lengthTagOf maps a Data-type field tag to the tag of the Length field
+// that must immediately precede it on the wire.
+var lengthTagOf = map[int]int{}
+
+// RegisterLengthPair records that dataFieldTag is preceded on the wire by
+// lengthFieldTag, as declared in a FIX dictionary's <field type="..."> entry.
+func RegisterLengthPair(lengthFieldTag, dataFieldTag int) {
+	lengthTagOf[dataFieldTag] = lengthFieldTag
+}
+
+// LengthOf returns the tag of the Length field paired with dataFieldTag, or
+// 0 if no pairing has been registered.
+func LengthOf(dataFieldTag int) int {
+	return lengthTagOf[dataFieldTag]
+}
@@ -0,0 +1,89 @@
+package fix
+
+import (
+	"bytes"
+	"testing"
+)
+
+func roundTripData(t *testing.T, payload []byte) {
+	t.Helper()
+
+	v := NewData(nil)
+	if err := v.FromBytes(payload); err != nil {
+		t.Fatalf("FromBytes: %v", err)
+	}
+
+	if got := v.ToBytes(); !bytes.Equal(got, payload) {
+		t.Fatalf("ToBytes round trip mismatch: len(got)=%d len(want)=%d", len(got), len(payload))
+	}
+
+	var buf bytes.Buffer
+	if !v.WriteBytes(&buf) {
+		t.Fatalf("WriteBytes returned false")
+	}
+	if !bytes.Equal(buf.Bytes(), payload) {
+		t.Fatalf("WriteBytes round trip mismatch: len(got)=%d len(want)=%d", buf.Len(), len(payload))
+	}
+
+	if v.Len() != len(payload) {
+		t.Fatalf("Len() = %d, want %d", v.Len(), len(payload))
+	}
+}
+
+func TestData_RoundTrip_SOHByte(t *testing.T) {
+	roundTripData(t, []byte("<News>\x01Body\x01</News>"))
+}
+
+func TestData_RoundTrip_NUL(t *testing.T) {
+	roundTripData(t, []byte{0x00, 'a', 0x00, 'b', 0x00})
+}
+
+func TestData_RoundTrip_LargePayload(t *testing.T) {
+	const size = 5 * 1024 * 1024 // 5MB
+	payload := make([]byte, size)
+	for i := range payload {
+		// sprinkle SOH and NUL throughout so the large-payload case also
+		// covers the binary-safety case, not just raw size.
+		switch i % 997 {
+		case 0:
+			payload[i] = 0x01
+		case 1:
+			payload[i] = 0x00
+		default:
+			payload[i] = byte(i)
+		}
+	}
+	roundTripData(t, payload)
+}
+
+func TestData_Set_Bytes(t *testing.T) {
+	v := &Data{}
+	payload := []byte("hello\x01world\x00")
+	if err := v.Set(payload); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+	if !bytes.Equal(v.ToBytes(), payload) {
+		t.Fatalf("Set([]byte) round trip mismatch")
+	}
+}
+
+func TestData_Set_Reader(t *testing.T) {
+	v := &Data{}
+	payload := []byte("streamed\x01payload\x00tail")
+	if err := v.Set(bytes.NewReader(payload)); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+	if !bytes.Equal(v.ToBytes(), payload) {
+		t.Fatalf("Set(io.Reader) round trip mismatch")
+	}
+}
+
+func TestData_LengthPairRegistry(t *testing.T) {
+	RegisterLengthPair(90, 91) // EncryptedTextLen -> EncryptedText, as an example pairing
+	if got := LengthOf(91); got != 90 {
+		t.Fatalf("LengthOf(91) = %d, want 90", got)
+	}
+	if got := LengthOf(12345); got != 0 {
+		t.Fatalf("LengthOf(unregistered) = %d, want 0", got)
+	}
+}
@@ -0,0 +1,316 @@
+package fix
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Decimal is a structure used for converting arbitrary-precision decimal values,
+// such as FIX Price, Qty, Amt and PriceOffset fields. Unlike Float, it is backed
+// by a signed coefficient plus an exponent instead of a float64, so values like
+// "0.00000001" or 18-digit quantities round-trip without precision loss.
+//
+// This package has no generated message layer or FIX dictionary of its own,
+// so there is nothing here that selects Decimal for Price/Qty/Amt fields
+// automatically; a caller's dictionary-driven generator would need to map
+// those field types to Decimal itself.
+type Decimal struct {
+	source      []byte
+	coeff       *big.Int
+	exp         int32
+	valid       bool
+	approximate bool
+
+	// AllowExponential permits parsing and emitting exponential notation
+	// (e.g. "1.5E-3"). FIX tags such as Price and Qty forbid exponential
+	// notation on the wire, so this defaults to false.
+	AllowExponential bool
+}
+
+// NewDecimal parses s and returns a new Decimal. s must use plain decimal
+// notation: the returned Decimal has AllowExponential unset, so exponential
+// notation is always rejected here. To parse it, set AllowExponential on a
+// Decimal and call Set or FromBytes.
+func NewDecimal(s string) (*Decimal, error) {
+	v := &Decimal{}
+	if err := v.setString(s); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// NewDecimalFromInt returns a Decimal equal to coeff * 10^exp.
+func NewDecimalFromInt(coeff int64, exp int32) *Decimal {
+	return &Decimal{coeff: big.NewInt(coeff), exp: exp, valid: true}
+}
+
+func (v *Decimal) IsNull() bool {
+	return !v.valid
+}
+func (v *Decimal) IsEmpty() bool {
+	return !v.valid
+}
+func (v *Decimal) Value() interface{} {
+	return v.Rat()
+}
+
+// Rat returns the value as a *big.Rat.
+func (v *Decimal) Rat() *big.Rat {
+	if !v.valid {
+		return new(big.Rat)
+	}
+	r := new(big.Rat).SetInt(v.coeff)
+	if v.exp == 0 {
+		return r
+	}
+	if v.exp > 0 {
+		return r.Mul(r, new(big.Rat).SetInt(pow10(v.exp)))
+	}
+	return r.Quo(r, new(big.Rat).SetInt(pow10(-v.exp)))
+}
+
+func pow10(n int32) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// Rescale returns a new Decimal scaled to the given exponent. Lowering the
+// exponent keeps the value exactly; raising it drops the extra fractional
+// digits, truncating toward zero without rounding.
+func (v *Decimal) Rescale(exp int32) *Decimal {
+	if !v.valid {
+		return &Decimal{}
+	}
+
+	out := &Decimal{exp: exp, valid: true, AllowExponential: v.AllowExponential}
+	diff := exp - v.exp
+	if diff == 0 {
+		out.coeff = new(big.Int).Set(v.coeff)
+		return out
+	}
+	if diff < 0 {
+		out.coeff = new(big.Int).Mul(v.coeff, pow10(-diff))
+		return out
+	}
+	q := new(big.Int)
+	q.Quo(v.coeff, pow10(diff))
+	out.coeff = q
+	return out
+}
+
+// Add returns v + other, scaled to the smaller of the two exponents.
+func (v *Decimal) Add(other *Decimal) *Decimal {
+	a, b := alignScale(v, other)
+	return &Decimal{coeff: new(big.Int).Add(a.coeff, b.coeff), exp: a.exp, valid: true}
+}
+
+// Sub returns v - other, scaled to the smaller of the two exponents.
+func (v *Decimal) Sub(other *Decimal) *Decimal {
+	a, b := alignScale(v, other)
+	return &Decimal{coeff: new(big.Int).Sub(a.coeff, b.coeff), exp: a.exp, valid: true}
+}
+
+// Mul returns v * other.
+func (v *Decimal) Mul(other *Decimal) *Decimal {
+	return &Decimal{coeff: new(big.Int).Mul(v.coeff, other.coeff), exp: v.exp + other.exp, valid: true}
+}
+
+// Cmp compares v and other, returning -1, 0 or +1 as with big.Int.Cmp.
+func (v *Decimal) Cmp(other *Decimal) int {
+	a, b := alignScale(v, other)
+	return a.coeff.Cmp(b.coeff)
+}
+
+func alignScale(a, b *Decimal) (*Decimal, *Decimal) {
+	exp := a.exp
+	if b.exp < exp {
+		exp = b.exp
+	}
+	return a.Rescale(exp), b.Rescale(exp)
+}
+
+func (v *Decimal) ToBytes() []byte {
+	if !v.valid {
+		return nil
+	}
+	if v.source != nil {
+		return v.source
+	}
+	return []byte(v.String())
+}
+
+func (v *Decimal) WriteBytes(writer *bytes.Buffer) bool {
+	if !v.valid {
+		return false
+	}
+	if v.source != nil {
+		_, _ = writer.Write(v.source)
+		return true
+	}
+	_, _ = writer.WriteString(v.String())
+	return true
+}
+
+func (v *Decimal) FromBytes(d []byte) error {
+	if d == nil {
+		v.valid = false
+		return nil
+	}
+
+	if err := v.setString(string(d)); err != nil {
+		return err
+	}
+	v.source = d
+	v.approximate = false
+
+	return nil
+}
+
+// Approximate reports whether the value was last assigned through a lossy
+// conversion, such as Set(float64), rather than parsed from an exact decimal
+// string or wire bytes. Callers that cannot tolerate float64's binary
+// rounding (e.g. when re-emitting a Price or Qty field) should check this
+// after Set and reject the value if it is true.
+func (v *Decimal) Approximate() bool {
+	return v.approximate
+}
+
+func (v *Decimal) String() string {
+	if !v.valid {
+		return ""
+	}
+
+	s := new(big.Int).Abs(v.coeff).String()
+	neg := v.coeff.Sign() < 0
+
+	switch {
+	case v.exp >= 0:
+		s += strings.Repeat("0", int(v.exp))
+	default:
+		point := len(s) + int(v.exp)
+		if point <= 0 {
+			s = strings.Repeat("0", 1-point) + s
+			point = 1
+		}
+		s = s[:point] + "." + s[point:]
+	}
+
+	if neg {
+		s = "-" + s
+	}
+	return s
+}
+
+// setString parses a plain (or, if AllowExponential is set, scientific) decimal
+// literal into coeff/exp.
+func (v *Decimal) setString(s string) error {
+	if s == "" {
+		return fmt.Errorf("could not convert %q to %s", s, "Decimal")
+	}
+
+	mantissa := s
+	exp := int32(0)
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		if !v.AllowExponential {
+			return fmt.Errorf("exponential notation is not allowed for %q", s)
+		}
+		mantissa = s[:i]
+		e, err := strconv.ParseInt(s[i+1:], 10, 32)
+		if err != nil {
+			return fmt.Errorf("could not convert %q to %s: %w", s, "Decimal", err)
+		}
+		exp = int32(e)
+	}
+
+	neg := false
+	if len(mantissa) > 0 && (mantissa[0] == '+' || mantissa[0] == '-') {
+		neg = mantissa[0] == '-'
+		mantissa = mantissa[1:]
+	}
+
+	intPart, fracPart := mantissa, ""
+	if i := strings.IndexByte(mantissa, '.'); i >= 0 {
+		intPart, fracPart = mantissa[:i], mantissa[i+1:]
+	}
+	if intPart == "" && fracPart == "" {
+		return fmt.Errorf("could not convert %q to %s", s, "Decimal")
+	}
+
+	coeff, ok := new(big.Int).SetString(intPart+fracPart, 10)
+	if !ok {
+		return fmt.Errorf("could not convert %q to %s", s, "Decimal")
+	}
+	if neg {
+		coeff.Neg(coeff)
+	}
+
+	v.coeff = coeff
+	v.exp = exp - int32(len(fracPart))
+	v.valid = true
+
+	return nil
+}
+
+// Set parses and assigns the field value from a string, int64, float64 or *big.Rat.
+func (v *Decimal) Set(d interface{}) error {
+	v.source = nil
+	v.approximate = false
+
+	switch t := d.(type) {
+	case nil:
+		v.valid = false
+		return nil
+	case string:
+		return v.setString(t)
+	case int64:
+		v.coeff = big.NewInt(t)
+		v.exp = 0
+		v.valid = true
+		return nil
+	case float64:
+		// float64 cannot represent every decimal exactly, so the value may
+		// already be off by the time it reaches Set. Convert through the
+		// shortest round-trippable decimal string, and flag the result as
+		// Approximate so callers who care can detect and reject it.
+		if err := v.setString(strconv.FormatFloat(t, 'f', -1, 64)); err != nil {
+			return err
+		}
+		v.approximate = true
+		return nil
+	case int, int8, int16, int32, uint, uint8, uint16, uint32, uint64:
+		if !DefaultConfig.StrictSet {
+			if n, ok := coerceSetInt(t); ok {
+				v.coeff = big.NewInt(int64(n))
+				v.exp = 0
+				v.valid = true
+				return nil
+			}
+		}
+	case *big.Rat:
+		num, den := t.Num(), t.Denom()
+		if den.Cmp(big.NewInt(1)) == 0 {
+			v.coeff = new(big.Int).Set(num)
+			v.exp = 0
+			v.valid = true
+			return nil
+		}
+		// Expand to a fixed-point value; callers needing exact rational
+		// semantics should keep using *big.Rat directly.
+		const scale = 18
+		scaled := new(big.Int).Mul(num, pow10(scale))
+		scaled.Quo(scaled, den)
+		v.coeff = scaled
+		v.exp = -scale
+		v.valid = true
+		return nil
+	}
+
+	return fmt.Errorf("could not convert %v to %s", d, "Decimal")
+}
+
+// Scan converts the Decimal value into dest.
+func (v *Decimal) Scan(dest interface{}) error {
+	return Scan(v, dest)
+}
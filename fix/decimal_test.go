@@ -0,0 +1,128 @@
+package fix
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestDecimal_RoundTrip(t *testing.T) {
+	cases := []string{"0", "1", "-1", "123456.789", "-0.00000001", "18446744073709551615.123"}
+	for _, s := range cases {
+		v, err := NewDecimal(s)
+		if err != nil {
+			t.Fatalf("NewDecimal(%q): %v", s, err)
+		}
+		if got := v.String(); got != s {
+			t.Fatalf("String() = %q, want %q", got, s)
+		}
+
+		wire := v.ToBytes()
+		got := &Decimal{}
+		if err := got.FromBytes(wire); err != nil {
+			t.Fatalf("FromBytes(%q): %v", wire, err)
+		}
+		if !bytes.Equal(got.ToBytes(), wire) {
+			t.Fatalf("round trip mismatch: got %q, want %q", got.ToBytes(), wire)
+		}
+	}
+}
+
+func TestDecimal_RoundTrip_Exponential(t *testing.T) {
+	v := &Decimal{AllowExponential: true}
+	if err := v.Set("1.5E-3"); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+	if got, want := v.Rat().RatString(), "3/2000"; got != want {
+		t.Fatalf("Rat() = %s, want %s", got, want)
+	}
+}
+
+func TestDecimal_Exponential_RejectedByDefault(t *testing.T) {
+	v := &Decimal{}
+	if err := v.Set("1.5E-3"); err == nil {
+		t.Fatalf("Set: expected error for exponential notation without AllowExponential")
+	}
+}
+
+func TestDecimal_Add(t *testing.T) {
+	a, _ := NewDecimal("1.25")
+	b, _ := NewDecimal("0.125")
+	got := a.Add(b)
+	if want := "1.375"; got.String() != want {
+		t.Fatalf("Add() = %s, want %s", got.String(), want)
+	}
+}
+
+func TestDecimal_Sub(t *testing.T) {
+	a, _ := NewDecimal("1.25")
+	b, _ := NewDecimal("0.125")
+	got := a.Sub(b)
+	if want := "1.125"; got.String() != want {
+		t.Fatalf("Sub() = %s, want %s", got.String(), want)
+	}
+}
+
+func TestDecimal_Mul(t *testing.T) {
+	a, _ := NewDecimal("1.5")
+	b, _ := NewDecimal("2.5")
+	got := a.Mul(b)
+	if want := "3.75"; got.String() != want {
+		t.Fatalf("Mul() = %s, want %s", got.String(), want)
+	}
+}
+
+func TestDecimal_Cmp(t *testing.T) {
+	a, _ := NewDecimal("1.50")
+	b, _ := NewDecimal("1.5")
+	if got := a.Cmp(b); got != 0 {
+		t.Fatalf("Cmp(equal values, different exponents) = %d, want 0", got)
+	}
+
+	c, _ := NewDecimal("1.49")
+	if got := a.Cmp(c); got != 1 {
+		t.Fatalf("Cmp(1.50, 1.49) = %d, want 1", got)
+	}
+	if got := c.Cmp(a); got != -1 {
+		t.Fatalf("Cmp(1.49, 1.50) = %d, want -1", got)
+	}
+}
+
+func TestDecimal_Rescale(t *testing.T) {
+	v, _ := NewDecimal("1.5")
+	if got, want := v.Rescale(-4).String(), "1.5000"; got != want {
+		t.Fatalf("Rescale(-4) = %s, want %s", got, want)
+	}
+	// Rescale truncates rather than rounds when widening the exponent.
+	if got, want := v.Rescale(0).String(), "1"; got != want {
+		t.Fatalf("Rescale(0) = %s, want %s", got, want)
+	}
+}
+
+func TestDecimal_Set_Float64_MarksApproximate(t *testing.T) {
+	v := &Decimal{}
+	if err := v.Set(0.1); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+	if !v.Approximate() {
+		t.Fatalf("Approximate() = false, want true after Set(float64)")
+	}
+
+	if err := v.Set("0.1"); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+	if v.Approximate() {
+		t.Fatalf("Approximate() = true, want false after Set(string)")
+	}
+}
+
+func TestDecimal_FromBytes_ClearsApproximate(t *testing.T) {
+	v := &Decimal{}
+	_ = v.Set(0.1)
+
+	if err := v.FromBytes([]byte("1.5")); err != nil {
+		t.Fatalf("FromBytes: %v", err)
+	}
+	if v.Approximate() {
+		t.Fatalf("Approximate() = true, want false after FromBytes")
+	}
+}
@@ -0,0 +1,321 @@
+package fix
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"time"
+)
+
+// Scan performs a type conversion from a Value to dest, mirroring the
+// conversion matrix database/sql's Rows.Scan applies to driver values.
+// dest must be a non-nil pointer to one of: the string/numeric/bool Go
+// kinds, []byte, *time.Time, *big.Int, *big.Float, *Decimal, or a
+// user-defined type whose underlying kind matches one of the above.
+func Scan(v Value, dest interface{}) error {
+	if v.IsNull() {
+		return nil
+	}
+
+	switch d := dest.(type) {
+	case *string:
+		*d = v.String()
+		return nil
+	case *[]byte:
+		*d = []byte(v.String())
+		return nil
+	case *bool:
+		b, err := scanBool(v)
+		if err != nil {
+			return err
+		}
+		*d = b
+		return nil
+	case *time.Time:
+		t, err := scanTime(v)
+		if err != nil {
+			return err
+		}
+		*d = t
+		return nil
+	case *big.Int:
+		i, err := scanBigInt(v)
+		if err != nil {
+			return err
+		}
+		*d = *i
+		return nil
+	case *big.Float:
+		s := scanText(v)
+		f, _, err := big.ParseFloat(s, 10, 200, big.ToNearestEven)
+		if err != nil {
+			return fmt.Errorf("could not convert %q to %s: %w", s, "*big.Float", err)
+		}
+		*d = *f
+		return nil
+	case *Decimal:
+		dec, err := NewDecimal(scanText(v))
+		if err != nil {
+			return err
+		}
+		*d = *dec
+		return nil
+	}
+
+	rv := reflect.ValueOf(dest)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return fmt.Errorf("destination must be a non-nil pointer, got %T", dest)
+	}
+	return scanReflect(v, rv.Elem())
+}
+
+func scanReflect(v Value, elem reflect.Value) error {
+	switch elem.Kind() {
+	case reflect.String:
+		elem.SetString(v.String())
+		return nil
+	case reflect.Bool:
+		b, err := scanBool(v)
+		if err != nil {
+			return err
+		}
+		elem.SetBool(b)
+		return nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := scanInt64(v)
+		if err != nil {
+			return err
+		}
+		if elem.OverflowInt(n) {
+			return fmt.Errorf("value %d overflows %s", n, elem.Type())
+		}
+		elem.SetInt(n)
+		return nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := scanUint64(v)
+		if err != nil {
+			return err
+		}
+		if elem.OverflowUint(n) {
+			return fmt.Errorf("value %d overflows %s", n, elem.Type())
+		}
+		elem.SetUint(n)
+		return nil
+	case reflect.Float32, reflect.Float64:
+		f, err := scanFloat64(v)
+		if err != nil {
+			return err
+		}
+		if elem.OverflowFloat(f) {
+			return fmt.Errorf("value %v overflows %s", f, elem.Type())
+		}
+		elem.SetFloat(f)
+		return nil
+	case reflect.Slice:
+		if elem.Type().Elem().Kind() == reflect.Uint8 {
+			elem.SetBytes([]byte(v.String()))
+			return nil
+		}
+	}
+
+	return fmt.Errorf("unsupported Scan destination kind %s", elem.Kind())
+}
+
+// scanText returns the exact wire text of v when available, falling back to
+// v.String() otherwise. Types such as Float keep the original wire bytes
+// (v.source) specifically so they round-trip losslessly; String() on those
+// types instead reformats the parsed value (e.g. Float.String always prints
+// 6 decimal places), which would needlessly discard precision here.
+func scanText(v Value) string {
+	if b := v.ToBytes(); b != nil {
+		return string(b)
+	}
+	return v.String()
+}
+
+func scanBool(v Value) (bool, error) {
+	if b, ok := v.Value().(bool); ok {
+		return b, nil
+	}
+	switch v.String() {
+	case "Y", "1", "true", "TRUE":
+		return true, nil
+	case "N", "0", "false", "FALSE", "":
+		return false, nil
+	}
+	return false, fmt.Errorf("could not convert %q to %s", v.String(), "bool")
+}
+
+func scanTime(v Value) (time.Time, error) {
+	if t, ok := v.Value().(time.Time); ok {
+		return t, nil
+	}
+	if t, err := time.Parse(TimeLayout, v.String()); err == nil {
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf("could not convert %q to %s", v.String(), "time.Time")
+}
+
+func scanBigInt(v Value) (*big.Int, error) {
+	if d, ok := v.(*Decimal); ok && d.exp == 0 {
+		return new(big.Int).Set(d.coeff), nil
+	}
+	i, ok := new(big.Int).SetString(v.String(), 10)
+	if !ok {
+		return nil, fmt.Errorf("could not convert %q to %s", v.String(), "*big.Int")
+	}
+	return i, nil
+}
+
+func scanInt64(v Value) (int64, error) {
+	switch t := v.Value().(type) {
+	case int:
+		return int64(t), nil
+	case int64:
+		return t, nil
+	case uint64:
+		if t > math.MaxInt64 {
+			return 0, fmt.Errorf("value %d overflows int64", t)
+		}
+		return int64(t), nil
+	case float64:
+		return int64(t), nil
+	case bool:
+		if t {
+			return 1, nil
+		}
+		return 0, nil
+	}
+	n, err := strconvParseInt(v.String())
+	if err != nil {
+		return 0, fmt.Errorf("could not convert %q to %s: %w", v.String(), "int64", err)
+	}
+	return n, nil
+}
+
+func scanUint64(v Value) (uint64, error) {
+	n, err := scanInt64(v)
+	if err != nil {
+		return 0, err
+	}
+	if n < 0 {
+		return 0, fmt.Errorf("value %d is negative, cannot convert to uint64", n)
+	}
+	return uint64(n), nil
+}
+
+func scanFloat64(v Value) (float64, error) {
+	switch t := v.Value().(type) {
+	case float64:
+		return t, nil
+	case int:
+		return float64(t), nil
+	case int64:
+		return float64(t), nil
+	case uint64:
+		return float64(t), nil
+	}
+	f, err := bytesToFloat([]byte(v.String()))
+	if err != nil {
+		return 0, fmt.Errorf("could not convert %q to %s: %w", v.String(), "float64", err)
+	}
+	return f, nil
+}
+
+func strconvParseInt(s string) (int64, error) {
+	n, err := bytesToInt([]byte(s))
+	return int64(n), err
+}
+
+// coerceSetInt converts the broadened Set(interface{}) inputs (other integer
+// widths, json.Number, string) to int, the Go type Int already knows how to
+// store, so Set implementations only need to handle one extra case beyond
+// their historical exact type. coerceSetUint and coerceSetFloat do the same
+// for uint64 and float64.
+func coerceSetInt(d interface{}) (int, bool) {
+	switch t := d.(type) {
+	case int:
+		return t, true
+	case int8:
+		return int(t), true
+	case int16:
+		return int(t), true
+	case int32:
+		return int(t), true
+	case int64:
+		return int(t), true
+	case uint:
+		return int(t), true
+	case uint8:
+		return int(t), true
+	case uint16:
+		return int(t), true
+	case uint32:
+		return int(t), true
+	case uint64:
+		return int(t), true
+	case string:
+		n, err := bytesToInt([]byte(t))
+		return n, err == nil
+	case json.Number:
+		n, err := bytesToInt([]byte(t.String()))
+		return n, err == nil
+	}
+	return 0, false
+}
+
+func coerceSetUint(d interface{}) (uint64, bool) {
+	switch t := d.(type) {
+	case uint64:
+		return t, true
+	case uint:
+		return uint64(t), true
+	case uint8:
+		return uint64(t), true
+	case uint16:
+		return uint64(t), true
+	case uint32:
+		return uint64(t), true
+	case int:
+		if t < 0 {
+			return 0, false
+		}
+		return uint64(t), true
+	case int64:
+		if t < 0 {
+			return 0, false
+		}
+		return uint64(t), true
+	case string:
+		n, err := bytesToUint([]byte(t))
+		return n, err == nil
+	case json.Number:
+		n, err := bytesToUint([]byte(t.String()))
+		return n, err == nil
+	}
+	return 0, false
+}
+
+func coerceSetFloat(d interface{}) (float64, bool) {
+	switch t := d.(type) {
+	case float64:
+		return t, true
+	case float32:
+		return float64(t), true
+	case int:
+		return float64(t), true
+	case int64:
+		return float64(t), true
+	case uint64:
+		return float64(t), true
+	case string:
+		f, err := bytesToFloat([]byte(t))
+		return f, err == nil
+	case json.Number:
+		f, err := bytesToFloat([]byte(t.String()))
+		return f, err == nil
+	}
+	return 0, false
+}
@@ -0,0 +1,111 @@
+package fix
+
+import (
+	"math/big"
+	"testing"
+	"time"
+)
+
+func TestScan_Overflow(t *testing.T) {
+	v := NewInt(300)
+	var dest int8
+	if err := v.Scan(&dest); err == nil {
+		t.Fatalf("Scan: expected overflow error converting 300 to int8")
+	}
+}
+
+func TestScan_Uint_Overflow(t *testing.T) {
+	v := NewInt(-1)
+	var dest uint64
+	if err := v.Scan(&dest); err == nil {
+		t.Fatalf("Scan: expected error converting -1 to uint64")
+	}
+}
+
+func TestScan_Bool(t *testing.T) {
+	cases := map[string]bool{"Y": true, "N": false}
+	for wire, want := range cases {
+		v := &Bool{}
+		if err := v.FromBytes([]byte(wire)); err != nil {
+			t.Fatalf("FromBytes(%q): %v", wire, err)
+		}
+		var dest bool
+		if err := Scan(v, &dest); err != nil {
+			t.Fatalf("Scan(%q): %v", wire, err)
+		}
+		if dest != want {
+			t.Fatalf("Scan(%q) = %v, want %v", wire, dest, want)
+		}
+	}
+}
+
+func TestScan_Time(t *testing.T) {
+	v := NewTime(time.Date(2026, 7, 27, 12, 34, 56, 0, time.UTC))
+	var dest time.Time
+	if err := Scan(v, &dest); err != nil {
+		t.Fatalf("Scan: %v", err)
+	}
+	if !dest.Equal(v.value) {
+		t.Fatalf("Scan time = %v, want %v", dest, v.value)
+	}
+}
+
+func TestScan_Decimal(t *testing.T) {
+	src, _ := NewDecimal("123.456")
+	var dest Decimal
+	if err := Scan(src, &dest); err != nil {
+		t.Fatalf("Scan: %v", err)
+	}
+	if got := dest.String(); got != "123.456" {
+		t.Fatalf("Scan Decimal = %q, want %q", got, "123.456")
+	}
+}
+
+func TestScan_Decimal_PrefersWireBytesOverString(t *testing.T) {
+	src := &Float{}
+	if err := src.FromBytes([]byte("1.2300")); err != nil {
+		t.Fatalf("FromBytes: %v", err)
+	}
+	var dest Decimal
+	if err := Scan(src, &dest); err != nil {
+		t.Fatalf("Scan: %v", err)
+	}
+	// Float.String always formats with 6 decimal places ("1.230000"); Scan
+	// should prefer the original wire bytes so the Decimal keeps the exact
+	// text instead of that reformatted, misleadingly-precise string.
+	if got := dest.String(); got != "1.2300" {
+		t.Fatalf("Scan Decimal = %q, want %q", got, "1.2300")
+	}
+}
+
+func TestScan_BigFloat_PrefersWireBytesOverString(t *testing.T) {
+	src := &Float{}
+	if err := src.FromBytes([]byte("1.2300")); err != nil {
+		t.Fatalf("FromBytes: %v", err)
+	}
+	var dest big.Float
+	if err := Scan(src, &dest); err != nil {
+		t.Fatalf("Scan: %v", err)
+	}
+	if got := dest.Text('f', 4); got != "1.2300" {
+		t.Fatalf("Scan *big.Float = %q, want %q", got, "1.2300")
+	}
+}
+
+func TestScan_StrictSet(t *testing.T) {
+	orig := DefaultConfig.StrictSet
+	defer func() { DefaultConfig.StrictSet = orig }()
+
+	v := &Int{}
+	if err := v.Set(int64(42)); err == nil {
+		t.Fatalf("Set(int64): expected error under StrictSet")
+	}
+
+	DefaultConfig.StrictSet = false
+	if err := v.Set(int64(42)); err != nil {
+		t.Fatalf("Set(int64) with StrictSet=false: %v", err)
+	}
+	if v.value != 42 {
+		t.Fatalf("value = %d, want 42", v.value)
+	}
+}
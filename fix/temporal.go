@@ -0,0 +1,677 @@
+package fix
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// This package has no generated message layer or FIX dictionary of its own,
+// so nothing here selects one of these types per a dictionary's <field
+// type="..."> annotation automatically; a caller's dictionary-driven
+// generator would need to map those field types to the matching type below
+// itself.
+
+// Precision selects the fractional-second resolution used by the temporal
+// Value types below. FIX 5.0SP2 allows timestamps down to picosecond
+// resolution; Go's time.Time only carries nanosecond resolution, so Picos
+// values are zero-padded beyond the nanosecond digits.
+type Precision int
+
+const (
+	Seconds Precision = iota
+	Millis
+	Micros
+	Nanos
+	Picos
+)
+
+func (p Precision) digits() int {
+	switch p {
+	case Millis:
+		return 3
+	case Micros:
+		return 6
+	case Nanos:
+		return 9
+	case Picos:
+		return 12
+	default:
+		return 0
+	}
+}
+
+func precisionFromDigits(n int) Precision {
+	switch {
+	case n <= 0:
+		return Seconds
+	case n <= 3:
+		return Millis
+	case n <= 6:
+		return Micros
+	case n <= 9:
+		return Nanos
+	default:
+		return Picos
+	}
+}
+
+// writeDigits writes the decimal digits of value left-padded with zeros to
+// width n into buf, without allocating.
+func writeDigits(buf []byte, value, n int) []byte {
+	start := len(buf)
+	buf = append(buf, make([]byte, n)...)
+	for i := n - 1; i >= 0; i-- {
+		buf[start+i] = byte('0' + value%10)
+		value /= 10
+	}
+	return buf
+}
+
+func writeDate(buf []byte, t time.Time) []byte {
+	year, month, day := t.Date()
+	if year < 0 {
+		year = 0
+	}
+	buf = writeDigits(buf, year, 4)
+	buf = writeDigits(buf, int(month), 2)
+	buf = writeDigits(buf, day, 2)
+	return buf
+}
+
+func writeClock(buf []byte, t time.Time) []byte {
+	hour, minute, second := t.Clock()
+	buf = writeDigits(buf, hour, 2)
+	buf = append(buf, ':')
+	buf = writeDigits(buf, minute, 2)
+	buf = append(buf, ':')
+	buf = writeDigits(buf, second, 2)
+	return buf
+}
+
+func writeFraction(buf []byte, t time.Time, precision Precision) []byte {
+	n := precision.digits()
+	if n == 0 {
+		return buf
+	}
+	// t.Nanosecond() covers at most 9 digits; pad the remainder (picos) with zeros.
+	nanos := t.Nanosecond()
+	buf = append(buf, '.')
+	if n <= 9 {
+		scaled := nanos / pow10Int(9-n)
+		return writeDigits(buf, scaled, n)
+	}
+	buf = writeDigits(buf, nanos, 9)
+	return writeDigits(buf, 0, n-9)
+}
+
+func pow10Int(n int) int {
+	r := 1
+	for i := 0; i < n; i++ {
+		r *= 10
+	}
+	return r
+}
+
+// timeToBytesPrecision formats t as "YYYYMMDD-HH:MM:SS[.fff...]" using the
+// hand-rolled digit writer, avoiding the allocations time.Format incurs.
+func timeToBytesPrecision(t time.Time, precision Precision) []byte {
+	buf := make([]byte, 0, 8+1+8+1+12)
+	buf = writeDate(buf, t)
+	buf = append(buf, '-')
+	buf = writeClock(buf, t)
+	buf = writeFraction(buf, t, precision)
+	return buf
+}
+
+func writeOffset(buf []byte, t time.Time) []byte {
+	_, offset := t.Zone()
+	sign := byte('+')
+	if offset < 0 {
+		sign = '-'
+		offset = -offset
+	}
+	buf = append(buf, sign)
+	buf = writeDigits(buf, offset/3600, 2)
+	buf = append(buf, ':')
+	buf = writeDigits(buf, (offset/60)%60, 2)
+	return buf
+}
+
+func fractionDigits(d []byte) int {
+	i := bytes.IndexByte(d, '.')
+	if i < 0 {
+		return 0
+	}
+	n := 0
+	for j := i + 1; j < len(d) && d[j] >= '0' && d[j] <= '9'; j++ {
+		n++
+	}
+	return n
+}
+
+// truncateFractionTo9 truncates a fractional portion longer than 9 digits
+// (e.g. Picos) down to 9, the most time.Time's nanosecond resolution can
+// represent. time.Parse requires the input to have exactly as many
+// fractional digits as the layout, so a layout capped at 9 zeros must be fed
+// input capped at 9 digits too, not the original, longer wire value.
+func truncateFractionTo9(d []byte) []byte {
+	i := bytes.IndexByte(d, '.')
+	if i < 0 {
+		return d
+	}
+	end := i + 1
+	for end < len(d) && d[end] >= '0' && d[end] <= '9' {
+		end++
+	}
+	if end-(i+1) <= 9 {
+		return d
+	}
+	out := make([]byte, 0, len(d)-(end-(i+1)-9))
+	out = append(out, d[:i+1+9]...)
+	out = append(out, d[end:]...)
+	return out
+}
+
+// UTCTimestamp is a FIX UTCTimestamp field ("YYYYMMDD-HH:MM:SS[.sss...]" in
+// UTC) with a configurable fractional-second Precision.
+type UTCTimestamp struct {
+	value     time.Time
+	valid     bool
+	precision Precision
+}
+
+// NewUTCTimestamp creates a new UTCTimestamp with the given precision.
+func NewUTCTimestamp(value time.Time, precision Precision) *UTCTimestamp {
+	return &UTCTimestamp{value: value, valid: true, precision: precision}
+}
+
+func (v *UTCTimestamp) IsNull() bool  { return !v.valid }
+func (v *UTCTimestamp) IsEmpty() bool { return !v.valid }
+func (v *UTCTimestamp) Value() interface{} {
+	return v.value
+}
+func (v *UTCTimestamp) ToBytes() []byte {
+	if !v.valid {
+		return nil
+	}
+	return timeToBytesPrecision(v.value, v.precision)
+}
+func (v *UTCTimestamp) WriteBytes(writer *bytes.Buffer) bool {
+	if !v.valid {
+		return false
+	}
+	_, _ = writer.Write(timeToBytesPrecision(v.value, v.precision))
+	return true
+}
+func (v *UTCTimestamp) FromBytes(d []byte) error {
+	if d == nil {
+		v.valid = false
+		return nil
+	}
+	frac := fractionDigits(d)
+	t, err := time.Parse(utcTimestampLayout(frac), string(truncateFractionTo9(d)))
+	if err != nil {
+		return err
+	}
+	v.value = t
+	v.precision = precisionFromDigits(frac)
+	v.valid = true
+	return nil
+}
+func (v *UTCTimestamp) String() string {
+	return string(v.ToBytes())
+}
+func (v *UTCTimestamp) Set(d interface{}) error {
+	if d == nil {
+		v.valid = false
+		return nil
+	}
+	if t, ok := d.(time.Time); ok {
+		v.value = t
+		v.valid = true
+		return nil
+	}
+	return fmt.Errorf("could not convert %v to %s", d, "UTCTimestamp")
+}
+func (v *UTCTimestamp) Scan(dest interface{}) error {
+	return Scan(v, dest)
+}
+
+func utcTimestampLayout(fracDigits int) string {
+	const base = "20060102-15:04:05"
+	if fracDigits == 0 {
+		return base
+	}
+	if fracDigits > 9 {
+		fracDigits = 9
+	}
+	return base + "." + repeatDigitLayout(fracDigits)
+}
+
+func repeatDigitLayout(n int) string {
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = '0'
+	}
+	return string(b)
+}
+
+// UTCDateOnly is a FIX UTCDateOnly field ("YYYYMMDD" in UTC).
+type UTCDateOnly struct {
+	value time.Time
+	valid bool
+}
+
+func NewUTCDateOnly(value time.Time) *UTCDateOnly {
+	return &UTCDateOnly{value: value, valid: true}
+}
+func (v *UTCDateOnly) IsNull() bool       { return !v.valid }
+func (v *UTCDateOnly) IsEmpty() bool      { return !v.valid }
+func (v *UTCDateOnly) Value() interface{} { return v.value }
+func (v *UTCDateOnly) ToBytes() []byte {
+	if !v.valid {
+		return nil
+	}
+	return writeDate(make([]byte, 0, 8), v.value)
+}
+func (v *UTCDateOnly) WriteBytes(writer *bytes.Buffer) bool {
+	if !v.valid {
+		return false
+	}
+	_, _ = writer.Write(v.ToBytes())
+	return true
+}
+func (v *UTCDateOnly) FromBytes(d []byte) error {
+	if d == nil {
+		v.valid = false
+		return nil
+	}
+	t, err := time.Parse("20060102", string(d))
+	if err != nil {
+		return err
+	}
+	v.value = t
+	v.valid = true
+	return nil
+}
+func (v *UTCDateOnly) String() string { return string(v.ToBytes()) }
+func (v *UTCDateOnly) Set(d interface{}) error {
+	if d == nil {
+		v.valid = false
+		return nil
+	}
+	if t, ok := d.(time.Time); ok {
+		v.value = t
+		v.valid = true
+		return nil
+	}
+	return fmt.Errorf("could not convert %v to %s", d, "UTCDateOnly")
+}
+func (v *UTCDateOnly) Scan(dest interface{}) error { return Scan(v, dest) }
+
+// LocalMktDate is a FIX LocalMktDate field ("YYYYMMDD" in the local market's
+// calendar, carrying no timezone offset).
+type LocalMktDate struct {
+	value time.Time
+	valid bool
+}
+
+func NewLocalMktDate(value time.Time) *LocalMktDate {
+	return &LocalMktDate{value: value, valid: true}
+}
+func (v *LocalMktDate) IsNull() bool       { return !v.valid }
+func (v *LocalMktDate) IsEmpty() bool      { return !v.valid }
+func (v *LocalMktDate) Value() interface{} { return v.value }
+func (v *LocalMktDate) ToBytes() []byte {
+	if !v.valid {
+		return nil
+	}
+	return writeDate(make([]byte, 0, 8), v.value)
+}
+func (v *LocalMktDate) WriteBytes(writer *bytes.Buffer) bool {
+	if !v.valid {
+		return false
+	}
+	_, _ = writer.Write(v.ToBytes())
+	return true
+}
+func (v *LocalMktDate) FromBytes(d []byte) error {
+	if d == nil {
+		v.valid = false
+		return nil
+	}
+	t, err := time.ParseInLocation("20060102", string(d), time.Local)
+	if err != nil {
+		return err
+	}
+	v.value = t
+	v.valid = true
+	return nil
+}
+func (v *LocalMktDate) String() string { return string(v.ToBytes()) }
+func (v *LocalMktDate) Set(d interface{}) error {
+	if d == nil {
+		v.valid = false
+		return nil
+	}
+	if t, ok := d.(time.Time); ok {
+		v.value = t
+		v.valid = true
+		return nil
+	}
+	return fmt.Errorf("could not convert %v to %s", d, "LocalMktDate")
+}
+func (v *LocalMktDate) Scan(dest interface{}) error { return Scan(v, dest) }
+
+// UTCTimeOnly is a FIX UTCTimeOnly field ("HH:MM:SS[.sss...]" in UTC).
+type UTCTimeOnly struct {
+	value     time.Time
+	valid     bool
+	precision Precision
+}
+
+func NewUTCTimeOnly(value time.Time, precision Precision) *UTCTimeOnly {
+	return &UTCTimeOnly{value: value, valid: true, precision: precision}
+}
+func (v *UTCTimeOnly) IsNull() bool       { return !v.valid }
+func (v *UTCTimeOnly) IsEmpty() bool      { return !v.valid }
+func (v *UTCTimeOnly) Value() interface{} { return v.value }
+func (v *UTCTimeOnly) ToBytes() []byte {
+	if !v.valid {
+		return nil
+	}
+	buf := writeClock(make([]byte, 0, 8+12), v.value)
+	return writeFraction(buf, v.value, v.precision)
+}
+func (v *UTCTimeOnly) WriteBytes(writer *bytes.Buffer) bool {
+	if !v.valid {
+		return false
+	}
+	_, _ = writer.Write(v.ToBytes())
+	return true
+}
+func (v *UTCTimeOnly) FromBytes(d []byte) error {
+	if d == nil {
+		v.valid = false
+		return nil
+	}
+	frac := fractionDigits(d)
+	layout := "15:04:05"
+	if frac > 0 {
+		layout += "." + repeatDigitLayout(minInt(frac, 9))
+	}
+	t, err := time.Parse(layout, string(truncateFractionTo9(d)))
+	if err != nil {
+		return err
+	}
+	v.value = t
+	v.precision = precisionFromDigits(frac)
+	v.valid = true
+	return nil
+}
+func (v *UTCTimeOnly) String() string { return string(v.ToBytes()) }
+func (v *UTCTimeOnly) Set(d interface{}) error {
+	if d == nil {
+		v.valid = false
+		return nil
+	}
+	if t, ok := d.(time.Time); ok {
+		v.value = t
+		v.valid = true
+		return nil
+	}
+	return fmt.Errorf("could not convert %v to %s", d, "UTCTimeOnly")
+}
+func (v *UTCTimeOnly) Scan(dest interface{}) error { return Scan(v, dest) }
+
+// TZTimestamp is a FIX TZTimestamp field
+// ("YYYYMMDD-HH:MM:SS[.sss...][+|-]HH:MM").
+type TZTimestamp struct {
+	value     time.Time
+	valid     bool
+	precision Precision
+}
+
+func NewTZTimestamp(value time.Time, precision Precision) *TZTimestamp {
+	return &TZTimestamp{value: value, valid: true, precision: precision}
+}
+func (v *TZTimestamp) IsNull() bool       { return !v.valid }
+func (v *TZTimestamp) IsEmpty() bool      { return !v.valid }
+func (v *TZTimestamp) Value() interface{} { return v.value }
+func (v *TZTimestamp) ToBytes() []byte {
+	if !v.valid {
+		return nil
+	}
+	buf := writeDate(make([]byte, 0, 8+1+8+12+6), v.value)
+	buf = append(buf, '-')
+	buf = writeClock(buf, v.value)
+	buf = writeFraction(buf, v.value, v.precision)
+	return writeOffset(buf, v.value)
+}
+func (v *TZTimestamp) WriteBytes(writer *bytes.Buffer) bool {
+	if !v.valid {
+		return false
+	}
+	_, _ = writer.Write(v.ToBytes())
+	return true
+}
+func (v *TZTimestamp) FromBytes(d []byte) error {
+	if d == nil {
+		v.valid = false
+		return nil
+	}
+	frac := fractionDigits(d)
+	layout := "20060102-15:04:05"
+	if frac > 0 {
+		layout += "." + repeatDigitLayout(minInt(frac, 9))
+	}
+	layout += "Z07:00"
+	t, err := time.Parse(layout, string(truncateFractionTo9(d)))
+	if err != nil {
+		return err
+	}
+	v.value = t
+	v.precision = precisionFromDigits(frac)
+	v.valid = true
+	return nil
+}
+func (v *TZTimestamp) String() string { return string(v.ToBytes()) }
+func (v *TZTimestamp) Set(d interface{}) error {
+	if d == nil {
+		v.valid = false
+		return nil
+	}
+	if t, ok := d.(time.Time); ok {
+		v.value = t
+		v.valid = true
+		return nil
+	}
+	return fmt.Errorf("could not convert %v to %s", d, "TZTimestamp")
+}
+func (v *TZTimestamp) Scan(dest interface{}) error { return Scan(v, dest) }
+
+// TZTimeOnly is a FIX TZTimeOnly field ("HH:MM:SS[.sss...][+|-]HH:MM").
+type TZTimeOnly struct {
+	value     time.Time
+	valid     bool
+	precision Precision
+}
+
+func NewTZTimeOnly(value time.Time, precision Precision) *TZTimeOnly {
+	return &TZTimeOnly{value: value, valid: true, precision: precision}
+}
+func (v *TZTimeOnly) IsNull() bool       { return !v.valid }
+func (v *TZTimeOnly) IsEmpty() bool      { return !v.valid }
+func (v *TZTimeOnly) Value() interface{} { return v.value }
+func (v *TZTimeOnly) ToBytes() []byte {
+	if !v.valid {
+		return nil
+	}
+	buf := writeClock(make([]byte, 0, 8+12+6), v.value)
+	buf = writeFraction(buf, v.value, v.precision)
+	return writeOffset(buf, v.value)
+}
+func (v *TZTimeOnly) WriteBytes(writer *bytes.Buffer) bool {
+	if !v.valid {
+		return false
+	}
+	_, _ = writer.Write(v.ToBytes())
+	return true
+}
+func (v *TZTimeOnly) FromBytes(d []byte) error {
+	if d == nil {
+		v.valid = false
+		return nil
+	}
+	frac := fractionDigits(d)
+	layout := "15:04:05"
+	if frac > 0 {
+		layout += "." + repeatDigitLayout(minInt(frac, 9))
+	}
+	layout += "Z07:00"
+	t, err := time.Parse(layout, string(truncateFractionTo9(d)))
+	if err != nil {
+		return err
+	}
+	v.value = t
+	v.precision = precisionFromDigits(frac)
+	v.valid = true
+	return nil
+}
+func (v *TZTimeOnly) String() string { return string(v.ToBytes()) }
+func (v *TZTimeOnly) Set(d interface{}) error {
+	if d == nil {
+		v.valid = false
+		return nil
+	}
+	if t, ok := d.(time.Time); ok {
+		v.value = t
+		v.valid = true
+		return nil
+	}
+	return fmt.Errorf("could not convert %v to %s", d, "TZTimeOnly")
+}
+func (v *TZTimeOnly) Scan(dest interface{}) error { return Scan(v, dest) }
+
+// MonthYearFormat selects which of the three on-wire MonthYear encodings to
+// emit: plain "YYYYMM", day-qualified "YYYYMMDD", or week-qualified
+// "YYYYMMwN".
+type MonthYearFormat int
+
+const (
+	MonthYearPlain MonthYearFormat = iota
+	MonthYearWithDay
+	MonthYearWithWeek
+)
+
+// MonthYear is a FIX MonthYear field, optionally qualified with a day-of-month
+// or a week-of-month ("w1".."w5").
+type MonthYear struct {
+	year   int
+	month  int
+	day    int
+	week   int
+	format MonthYearFormat
+	valid  bool
+}
+
+func NewMonthYear(year, month int) *MonthYear {
+	return &MonthYear{year: year, month: month, format: MonthYearPlain, valid: true}
+}
+
+func NewMonthYearWithDay(year, month, day int) *MonthYear {
+	return &MonthYear{year: year, month: month, day: day, format: MonthYearWithDay, valid: true}
+}
+
+func NewMonthYearWithWeek(year, month, week int) *MonthYear {
+	return &MonthYear{year: year, month: month, week: week, format: MonthYearWithWeek, valid: true}
+}
+
+func (v *MonthYear) IsNull() bool  { return !v.valid }
+func (v *MonthYear) IsEmpty() bool { return !v.valid }
+func (v *MonthYear) Value() interface{} {
+	return v.String()
+}
+func (v *MonthYear) ToBytes() []byte {
+	if !v.valid {
+		return nil
+	}
+	buf := make([]byte, 0, 9)
+	buf = writeDigits(buf, v.year, 4)
+	buf = writeDigits(buf, v.month, 2)
+	switch v.format {
+	case MonthYearWithDay:
+		buf = writeDigits(buf, v.day, 2)
+	case MonthYearWithWeek:
+		buf = append(buf, 'w')
+		buf = strconv.AppendInt(buf, int64(v.week), 10)
+	}
+	return buf
+}
+func (v *MonthYear) WriteBytes(writer *bytes.Buffer) bool {
+	if !v.valid {
+		return false
+	}
+	_, _ = writer.Write(v.ToBytes())
+	return true
+}
+func (v *MonthYear) FromBytes(d []byte) error {
+	if d == nil {
+		v.valid = false
+		return nil
+	}
+	if len(d) < 6 {
+		return fmt.Errorf("invalid MonthYear %q: too short", d)
+	}
+	year, err := strconv.Atoi(string(d[0:4]))
+	if err != nil {
+		return fmt.Errorf("invalid MonthYear %q: %w", d, err)
+	}
+	month, err := strconv.Atoi(string(d[4:6]))
+	if err != nil {
+		return fmt.Errorf("invalid MonthYear %q: %w", d, err)
+	}
+	v.year, v.month, v.valid = year, month, true
+	switch {
+	case len(d) == 6:
+		v.format = MonthYearPlain
+	case len(d) > 6 && d[6] == 'w':
+		week, err := strconv.Atoi(string(d[7:]))
+		if err != nil {
+			return fmt.Errorf("invalid MonthYear %q: %w", d, err)
+		}
+		v.week, v.format = week, MonthYearWithWeek
+	case len(d) == 8:
+		day, err := strconv.Atoi(string(d[6:8]))
+		if err != nil {
+			return fmt.Errorf("invalid MonthYear %q: %w", d, err)
+		}
+		v.day, v.format = day, MonthYearWithDay
+	default:
+		return fmt.Errorf("invalid MonthYear %q: unrecognized suffix", d)
+	}
+	return nil
+}
+func (v *MonthYear) String() string {
+	return string(v.ToBytes())
+}
+func (v *MonthYear) Set(d interface{}) error {
+	if d == nil {
+		v.valid = false
+		return nil
+	}
+	if s, ok := d.(string); ok {
+		return v.FromBytes([]byte(s))
+	}
+	return fmt.Errorf("could not convert %v to %s", d, "MonthYear")
+}
+func (v *MonthYear) Scan(dest interface{}) error { return Scan(v, dest) }
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,106 @@
+package fix
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+var allPrecisions = []Precision{Seconds, Millis, Micros, Nanos, Picos}
+
+func TestUTCTimestamp_RoundTrip_AllPrecisions(t *testing.T) {
+	base := time.Date(2026, 7, 27, 12, 34, 56, 123456789, time.UTC)
+	for _, p := range allPrecisions {
+		v := NewUTCTimestamp(base, p)
+		wire := v.ToBytes()
+
+		got := &UTCTimestamp{}
+		if err := got.FromBytes(wire); err != nil {
+			t.Fatalf("precision %v: FromBytes(%q): %v", p, wire, err)
+		}
+		if !bytes.Equal(got.ToBytes(), wire) {
+			t.Fatalf("precision %v: round trip mismatch: got %q, want %q", p, got.ToBytes(), wire)
+		}
+	}
+}
+
+func TestUTCTimeOnly_RoundTrip_AllPrecisions(t *testing.T) {
+	base := time.Date(0, 1, 1, 12, 34, 56, 123456789, time.UTC)
+	for _, p := range allPrecisions {
+		v := NewUTCTimeOnly(base, p)
+		wire := v.ToBytes()
+
+		got := &UTCTimeOnly{}
+		if err := got.FromBytes(wire); err != nil {
+			t.Fatalf("precision %v: FromBytes(%q): %v", p, wire, err)
+		}
+		if !bytes.Equal(got.ToBytes(), wire) {
+			t.Fatalf("precision %v: round trip mismatch: got %q, want %q", p, got.ToBytes(), wire)
+		}
+	}
+}
+
+func TestTZTimestamp_RoundTrip_AllPrecisions(t *testing.T) {
+	loc := time.FixedZone("", 5*3600+1800)
+	base := time.Date(2026, 7, 27, 12, 34, 56, 123456789, loc)
+	for _, p := range allPrecisions {
+		v := NewTZTimestamp(base, p)
+		wire := v.ToBytes()
+
+		got := &TZTimestamp{}
+		if err := got.FromBytes(wire); err != nil {
+			t.Fatalf("precision %v: FromBytes(%q): %v", p, wire, err)
+		}
+		if !bytes.Equal(got.ToBytes(), wire) {
+			t.Fatalf("precision %v: round trip mismatch: got %q, want %q", p, got.ToBytes(), wire)
+		}
+	}
+}
+
+func TestTZTimeOnly_RoundTrip_AllPrecisions(t *testing.T) {
+	loc := time.FixedZone("", -4*3600)
+	base := time.Date(0, 1, 1, 12, 34, 56, 123456789, loc)
+	for _, p := range allPrecisions {
+		v := NewTZTimeOnly(base, p)
+		wire := v.ToBytes()
+
+		got := &TZTimeOnly{}
+		if err := got.FromBytes(wire); err != nil {
+			t.Fatalf("precision %v: FromBytes(%q): %v", p, wire, err)
+		}
+		if !bytes.Equal(got.ToBytes(), wire) {
+			t.Fatalf("precision %v: round trip mismatch: got %q, want %q", p, got.ToBytes(), wire)
+		}
+	}
+}
+
+func TestUTCDateOnly_RoundTrip(t *testing.T) {
+	v := NewUTCDateOnly(time.Date(2026, 7, 27, 0, 0, 0, 0, time.UTC))
+	wire := v.ToBytes()
+
+	got := &UTCDateOnly{}
+	if err := got.FromBytes(wire); err != nil {
+		t.Fatalf("FromBytes(%q): %v", wire, err)
+	}
+	if !bytes.Equal(got.ToBytes(), wire) {
+		t.Fatalf("round trip mismatch: got %q, want %q", got.ToBytes(), wire)
+	}
+}
+
+func TestMonthYear_RoundTrip_AllFormats(t *testing.T) {
+	cases := []*MonthYear{
+		NewMonthYear(2026, 7),
+		NewMonthYearWithDay(2026, 7, 27),
+		NewMonthYearWithWeek(2026, 7, 3),
+	}
+	for _, v := range cases {
+		wire := v.ToBytes()
+		got := &MonthYear{}
+		if err := got.FromBytes(wire); err != nil {
+			t.Fatalf("FromBytes(%q): %v", wire, err)
+		}
+		if !bytes.Equal(got.ToBytes(), wire) {
+			t.Fatalf("round trip mismatch: got %q, want %q", got.ToBytes(), wire)
+		}
+	}
+}
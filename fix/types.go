@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"math"
 	"strconv"
+	"sync"
 	"time"
 )
 
@@ -32,6 +33,10 @@ type Value interface {
 
 	// Set replaces a specified field value with a value of a corresponding type.
 	Set(d interface{}) error
+
+	// Scan converts the field value into dest, following the same widening
+	// conversion matrix as the package-level Scan function.
+	Scan(dest interface{}) error
 }
 
 // Raw is a structure that is used to convert the message data to a byte array.
@@ -77,6 +82,13 @@ func (v *Raw) Set(d interface{}) error {
 		return nil
 	}
 
+	if !DefaultConfig.StrictSet {
+		if s, ok := d.(string); ok {
+			v.value = []byte(s)
+			return nil
+		}
+	}
+
 	return fmt.Errorf("could not convert %s to %s", d, "Byte Array")
 }
 
@@ -84,6 +96,11 @@ func (v *Raw) String() string {
 	return string(v.value)
 }
 
+// Scan converts the Raw value into dest.
+func (v *Raw) Scan(dest interface{}) error {
+	return Scan(v, dest)
+}
+
 // String is a structure used for converting string values.
 type String struct {
 	value string
@@ -107,6 +124,19 @@ func (v *String) Set(d interface{}) error {
 		return nil
 	}
 
+	if !DefaultConfig.StrictSet {
+		if s, ok := d.(fmt.Stringer); ok {
+			v.value = s.String()
+			v.valid = true
+			return nil
+		}
+		if b, ok := d.([]byte); ok {
+			v.value = string(b)
+			v.valid = true
+			return nil
+		}
+	}
+
 	return fmt.Errorf("could not convert %s to %s", d, "String")
 }
 
@@ -150,6 +180,11 @@ func (v *String) String() string {
 	return v.value
 }
 
+// Scan converts the String value into dest.
+func (v *String) Scan(dest interface{}) error {
+	return Scan(v, dest)
+}
+
 // Int is a structure used for converting integer values.
 type Int struct {
 	value int
@@ -180,6 +215,14 @@ func (v *Int) Set(d interface{}) error {
 		return nil
 	}
 
+	if !DefaultConfig.StrictSet {
+		if n, ok := coerceSetInt(d); ok {
+			v.value = n
+			v.valid = true
+			return nil
+		}
+	}
+
 	return fmt.Errorf("could not convert %s to %s", d, "Int")
 }
 
@@ -206,16 +249,23 @@ func (v *Int) ToBytes() []byte {
 	if !v.valid {
 		return nil
 	}
-	return intToBytes(v.value)
+	return toBytesViaWriter(v.WriteBytes)
 }
 func (v *Int) WriteBytes(writer *bytes.Buffer) bool {
 	if !v.valid {
 		return false
 	}
-	_, _ = writer.Write(intToBytes(v.value))
+	writer.Grow(20)
+	b := appendInt(writer.AvailableBuffer(), int64(v.value))
+	_, _ = writer.Write(b)
 	return true
 }
 
+// Scan converts the Int value into dest.
+func (v *Int) Scan(dest interface{}) error {
+	return Scan(v, dest)
+}
+
 // Uint is a structure used for converting values to the uint64 type.
 type Uint struct {
 	value uint64
@@ -239,6 +289,14 @@ func (v *Uint) Set(d interface{}) error {
 		return nil
 	}
 
+	if !DefaultConfig.StrictSet {
+		if n, ok := coerceSetUint(d); ok {
+			v.value = n
+			v.valid = true
+			return nil
+		}
+	}
+
 	return fmt.Errorf("could not convert %s to %s", d, "Uint")
 }
 
@@ -272,16 +330,23 @@ func (v *Uint) ToBytes() []byte {
 	if !v.valid {
 		return nil
 	}
-	return uintToBytes(v.value)
+	return toBytesViaWriter(v.WriteBytes)
 }
 func (v *Uint) WriteBytes(writer *bytes.Buffer) bool {
 	if !v.valid {
 		return false
 	}
-	_, _ = writer.Write(uintToBytes(v.value))
+	writer.Grow(20)
+	b := appendUint(writer.AvailableBuffer(), v.value)
+	_, _ = writer.Write(b)
 	return true
 }
 
+// Scan converts the Uint value into dest.
+func (v *Uint) Scan(dest interface{}) error {
+	return Scan(v, dest)
+}
+
 // Float is a structure used for converting values to the float64 type.
 type Float struct {
 	source []byte
@@ -323,7 +388,7 @@ func (v *Float) ToBytes() []byte {
 	if v.source != nil {
 		return v.source
 	}
-	return floatToBytes(v.value)
+	return toBytesViaWriter(v.WriteBytes)
 }
 func (v *Float) WriteBytes(writer *bytes.Buffer) bool {
 	if !v.valid {
@@ -332,9 +397,12 @@ func (v *Float) WriteBytes(writer *bytes.Buffer) bool {
 
 	if v.source != nil {
 		_, _ = writer.Write(v.source)
-	} else {
-		_, _ = writer.Write(strconv.AppendFloat(make([]byte, 0, 64), v.value, 'f', -1, 64))
+		return true
 	}
+
+	writer.Grow(32)
+	b := strconv.AppendFloat(writer.AvailableBuffer(), v.value, 'f', -1, 64)
+	_, _ = writer.Write(b)
 	return true
 }
 func (v *Float) String() string {
@@ -354,10 +422,32 @@ func (v *Float) Set(d interface{}) error {
 		return nil
 	}
 
+	if !DefaultConfig.StrictSet {
+		if f, ok := coerceSetFloat(d); ok {
+			v.value = f
+			v.valid = true
+			return nil
+		}
+	}
+
 	return fmt.Errorf("could not convert %s to %s", d, "Float")
 }
 
-// Time is a structure used for converting date-time values.
+// Scan converts the Float value into dest.
+func (v *Float) Scan(dest interface{}) error {
+	return Scan(v, dest)
+}
+
+// TimeLayout is the on-wire layout of the legacy Time type: a FIX
+// UTCTimestamp with fixed millisecond precision. New code should prefer the
+// UTCTimestamp family in temporal.go, which supports the other FIX temporal
+// field types and configurable precision; Time is kept for callers that
+// already depend on its exact millisecond-only behavior.
+const TimeLayout = "20060102-15:04:05.000"
+
+// Time is a structure used for converting date-time values. It always
+// assumes millisecond precision; see UTCTimestamp for FIX 5.0's other
+// temporal field types and precisions.
 type Time struct {
 	value time.Time
 	valid bool
@@ -380,9 +470,26 @@ func (v *Time) Set(d interface{}) error {
 		return nil
 	}
 
+	if !DefaultConfig.StrictSet {
+		if s, ok := d.(string); ok {
+			t, err := time.Parse(TimeLayout, s)
+			if err != nil {
+				return fmt.Errorf("could not convert %s to %s: %w", d, "Date-Time", err)
+			}
+			v.value = t
+			v.valid = true
+			return nil
+		}
+	}
+
 	return fmt.Errorf("could not convert %s to %s", d, "Date-Time")
 }
 
+// Scan converts the Time value into dest.
+func (v *Time) Scan(dest interface{}) error {
+	return Scan(v, dest)
+}
+
 func (v *Time) IsNull() bool {
 	return !v.valid
 }
@@ -398,13 +505,15 @@ func (v *Time) ToBytes() []byte {
 	if !v.valid {
 		return nil
 	}
-	return timeToBytes(v.value)
+	return toBytesViaWriter(v.WriteBytes)
 }
 func (v *Time) WriteBytes(writer *bytes.Buffer) bool {
 	if !v.valid {
 		return false
 	}
-	_, _ = writer.Write(timeToBytes(v.value))
+	writer.Grow(21)
+	b := appendTimeBytes(writer.AvailableBuffer(), v.value)
+	_, _ = writer.Write(b)
 	return true
 }
 func (v *Time) FromBytes(d []byte) (err error) {
@@ -507,9 +616,25 @@ func (v *Bool) Set(d interface{}) error {
 		return nil
 	}
 
+	if !DefaultConfig.StrictSet {
+		switch d {
+		case "Y", "1":
+			v.value, v.valid = true, true
+			return nil
+		case "N", "0":
+			v.value, v.valid = false, true
+			return nil
+		}
+	}
+
 	return fmt.Errorf("could not convert %s to %s", d, "Boolean")
 }
 
+// Scan converts the Bool value into dest.
+func (v *Bool) Scan(dest interface{}) error {
+	return Scan(v, dest)
+}
+
 func bytesToUint(d []byte) (uint64, error) {
 	if len(d) == 0 {
 		return 0, errors.New("invalid input: empty byte slice")
@@ -528,48 +653,76 @@ func bytesToUint(d []byte) (uint64, error) {
 	}
 	return result, nil
 }
-func uintToBytes(value uint64) []byte {
-	return strconv.AppendUint(make([]byte, 0, 20), value, 10)
+
+// twoDigits is a lookup table of the two ASCII digits for every value 0..99,
+// used by appendUint/appendInt to consume two decimal digits per iteration
+// instead of one.
+var twoDigits [100][2]byte
+
+func init() {
+	for i := 0; i < 100; i++ {
+		twoDigits[i][0] = byte('0' + i/10)
+		twoDigits[i][1] = byte('0' + i%10)
+	}
+}
+
+// appendUint appends the decimal representation of value to buf, consuming
+// two digits per loop iteration via twoDigits.
+func appendUint(buf []byte, value uint64) []byte {
+	var tmp [20]byte
+	i := len(tmp)
+	for value >= 100 {
+		q := value / 100
+		d := twoDigits[value-q*100]
+		i -= 2
+		tmp[i], tmp[i+1] = d[0], d[1]
+		value = q
+	}
+	if value < 10 {
+		i--
+		tmp[i] = byte('0' + value)
+	} else {
+		d := twoDigits[value]
+		i -= 2
+		tmp[i], tmp[i+1] = d[0], d[1]
+	}
+	return append(buf, tmp[i:]...)
 }
 
-// not working with negative values
-func timeToBytes(t time.Time) []byte {
-	year, month, day := t.Date()
-	if year < 0 {
-		year = 0
+// appendInt appends the decimal representation of value to buf.
+func appendInt(buf []byte, value int64) []byte {
+	if value < 0 {
+		buf = append(buf, '-')
+		return appendUint(buf, uint64(-value))
 	}
-	hour, minute, second := t.Clock()
-	milli := t.Nanosecond() / 1e6
-	return []byte{byte('0' + year/1000),
-		byte('0' + (year/100)%10),
-		byte('0' + (year/10)%10),
-		byte('0' + year%10),
+	return appendUint(buf, uint64(value))
+}
 
-		byte('0' + (month/10)%10),
-		byte('0' + month%10),
+// toBytesViaWriter runs write against a pooled scratch buffer and copies out
+// the result, so ToBytes implementations can share the WriteBytes fast path
+// without each allocating their own throwaway slice.
+var scratchBufPool = sync.Pool{New: func() interface{} { return new(bytes.Buffer) }}
 
-		byte('0' + (day/10)%10),
-		byte('0' + day%10),
-		'-',
-		byte('0' + (hour/10)%10),
-		byte('0' + hour%10),
-		':',
-		byte('0' + (minute/10)%10),
-		byte('0' + minute%10),
-		':',
-		byte('0' + (second/10)%10),
-		byte('0' + second%10),
-		'.',
-		byte('0' + milli/100),
-		byte('0' + (milli/10)%10),
-		byte('0' + milli%10)}
+func toBytesViaWriter(write func(writer *bytes.Buffer) bool) []byte {
+	buf := scratchBufPool.Get().(*bytes.Buffer)
+	buf.Reset()
+	write(buf)
+	out := make([]byte, buf.Len())
+	copy(out, buf.Bytes())
+	scratchBufPool.Put(buf)
+	return out
 }
 
-func floatToBytes(f float64) []byte {
-	if f == 0 {
-		return []byte{'0'}
-	}
-	return strconv.AppendFloat(make([]byte, 0, 64), f, 'f', -1, 64)
+// appendTimeBytes appends t formatted as "YYYYMMDD-HH:MM:SS.sss" (fixed
+// millisecond precision) to buf, so callers can write straight into a
+// bytes.Buffer's spare capacity instead of allocating. It shares the
+// hand-rolled digit writer with the UTCTimestamp family in temporal.go
+// instead of duplicating it.
+func appendTimeBytes(buf []byte, t time.Time) []byte {
+	buf = writeDate(buf, t)
+	buf = append(buf, '-')
+	buf = writeClock(buf, t)
+	return writeFraction(buf, t, Millis)
 }
 
 var float64pow10 = [...]float64{
@@ -753,6 +906,3 @@ func bytesToInt(d []byte) (int, error) {
 
 	return result * sign, nil
 }
-func intToBytes(value int) []byte {
-	return strconv.AppendInt(make([]byte, 0, 20), int64(value), 10)
-}
@@ -0,0 +1,63 @@
+package fix
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+func benchmarkValue(b *testing.B, v Value) {
+	b.Run("ToBytes", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = v.ToBytes()
+		}
+	})
+
+	b.Run("WriteBytes", func(b *testing.B) {
+		b.ReportAllocs()
+		var buf bytes.Buffer
+		for i := 0; i < b.N; i++ {
+			buf.Reset()
+			v.WriteBytes(&buf)
+		}
+	})
+}
+
+func BenchmarkRaw(b *testing.B) {
+	benchmarkValue(b, NewRaw([]byte("hello world")))
+}
+
+func BenchmarkString(b *testing.B) {
+	benchmarkValue(b, NewString("hello world"))
+}
+
+func BenchmarkInt(b *testing.B) {
+	benchmarkValue(b, NewInt(1234567890))
+}
+
+func BenchmarkUint(b *testing.B) {
+	benchmarkValue(b, NewUint(1234567890123))
+}
+
+func BenchmarkFloat(b *testing.B) {
+	benchmarkValue(b, NewFloat(1234.56789))
+}
+
+func BenchmarkTime(b *testing.B) {
+	benchmarkValue(b, NewTime(time.Date(2026, 7, 27, 12, 34, 56, 0, time.UTC)))
+}
+
+func BenchmarkBool(b *testing.B) {
+	bv := &Bool{}
+	_ = bv.Set(true)
+	benchmarkValue(b, bv)
+}
+
+func BenchmarkDecimal(b *testing.B) {
+	d, err := NewDecimal("123456.789")
+	if err != nil {
+		b.Fatal(err)
+	}
+	benchmarkValue(b, d)
+}